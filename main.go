@@ -2,11 +2,15 @@ package main
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -14,6 +18,14 @@ const (
 	configFileName = "folders"
 )
 
+// entry is a single record in the jump list: a path plus the frecency
+// bookkeeping used to rank it (modeled on autojump/z).
+type entry struct {
+	Path       string
+	HitCount   int
+	LastAccess int64 // unix seconds
+}
+
 func main() {
 	// Get home directory for config file
 	homeDir, err := os.UserHomeDir()
@@ -22,15 +34,16 @@ func main() {
 		os.Exit(1)
 	}
 
-	configDir := filepath.Join(homeDir, configDirName)
-	configPath := filepath.Join(configDir, configFileName)
+	configPath := resolveConfig(homeDir)
 
 	// Ensure config directory exists
-	if err := os.MkdirAll(configDir, 0755); err != nil {
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
 		fmt.Fprintf(os.Stderr, "Error creating config directory: %v\n", err)
 		os.Exit(1)
 	}
 
+	migrateLegacyConfig(homeDir, configPath)
+
 	// Ensure config file exists
 	if _, err := os.Stat(configPath); os.IsNotExist(err) {
 		file, err := os.Create(configPath)
@@ -51,21 +64,93 @@ func main() {
 	case "add":
 		addFolder(configPath)
 	case "list":
-		listFolders(configPath)
+		listFolders(configPath, flagValue(os.Args[2:], "format"))
 	case "setup":
-		setupJumper(configDir, homeDir)
+		setupJumper(homeDir, flagValue(os.Args[2:], "shell"))
 	case "remove":
 		if len(os.Args) < 3 {
 			fmt.Fprintf(os.Stderr, "Usage: jumper remove <folder-name-or-number>\n")
 			os.Exit(1)
 		}
 		removeFolder(configPath, os.Args[2])
+	case "clean":
+		cleanFolders(configPath, os.Args[2:])
+	case "export":
+		exportFolders(configPath, os.Args[2:])
+	case "import":
+		if len(os.Args) < 3 {
+			fmt.Fprintf(os.Stderr, "Usage: jumper import <file> [--merge|--replace] [--rebase=<old>=<new>] [--strict]\n")
+			os.Exit(1)
+		}
+		importFolders(configPath, os.Args[2], os.Args[3:])
 	default:
 		// Treat as a jump target
 		jumpToFolder(configPath, os.Args[1])
 	}
 }
 
+// resolveConfig resolves the path to the folder-list file. Precedence:
+//  1. $JUMPER_CONFIG, if set, used verbatim as the file path.
+//  2. $XDG_CONFIG_HOME/jumper/folders (or ~/.config/jumper/folders),
+//     if that base config directory already exists.
+//  3. ~/.jumper/folders, for backward compatibility.
+func resolveConfig(homeDir string) string {
+	if v := os.Getenv("JUMPER_CONFIG"); v != "" {
+		return v
+	}
+
+	xdgConfigHome := os.Getenv("XDG_CONFIG_HOME")
+	if xdgConfigHome == "" {
+		xdgConfigHome = filepath.Join(homeDir, ".config")
+	}
+
+	if info, err := os.Stat(xdgConfigHome); err == nil && info.IsDir() {
+		return filepath.Join(xdgConfigHome, "jumper", configFileName)
+	}
+
+	return filepath.Join(homeDir, configDirName, configFileName)
+}
+
+// resolveDataDir resolves the directory the generated shell integration
+// script is written to, following the same XDG-vs-legacy precedence as
+// resolveConfig (but with no env-var override, since there's no
+// equivalent of JUMPER_CONFIG for it).
+func resolveDataDir(homeDir string) string {
+	xdgDataHome := os.Getenv("XDG_DATA_HOME")
+	if xdgDataHome == "" {
+		xdgDataHome = filepath.Join(homeDir, ".local", "share")
+	}
+
+	if info, err := os.Stat(xdgDataHome); err == nil && info.IsDir() {
+		return filepath.Join(xdgDataHome, "jumper")
+	}
+
+	return filepath.Join(homeDir, configDirName)
+}
+
+// migrateLegacyConfig moves the legacy ~/.jumper/folders file to
+// configPath if resolveConfig now points somewhere else (e.g. a user
+// upgrading onto an XDG-compliant system) and nothing has been written
+// to the new location yet.
+func migrateLegacyConfig(homeDir, configPath string) {
+	legacyPath := filepath.Join(homeDir, configDirName, configFileName)
+	if configPath == legacyPath {
+		return
+	}
+	if _, err := os.Stat(configPath); err == nil {
+		return
+	}
+	if _, err := os.Stat(legacyPath); err != nil {
+		return
+	}
+
+	if err := os.Rename(legacyPath, configPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error migrating %s to %s: %v\n", legacyPath, configPath, err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Migrated folder list from %s to %s\n", legacyPath, configPath)
+}
+
 // addFolder adds the current directory to the jump list
 func addFolder(configPath string) {
 	// Get current directory
@@ -76,28 +161,23 @@ func addFolder(configPath string) {
 	}
 
 	// Check if folder already exists in the list
-	folders, err := readFolderList(configPath)
+	entries, err := readEntries(configPath)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error reading folder list: %v\n", err)
 		os.Exit(1)
 	}
 
-	for _, folder := range folders {
-		if folder == currentDir {
+	for _, e := range entries {
+		if e.Path == currentDir {
 			fmt.Printf("Current folder already in the list: %s\n", currentDir)
 			return
 		}
 	}
 
-	// Add to config file
-	file, err := os.OpenFile(configPath, os.O_APPEND|os.O_WRONLY, 0644)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error opening config file: %v\n", err)
-		os.Exit(1)
-	}
-	defer file.Close()
+	now := time.Now().Unix()
+	entries = append(entries, &entry{Path: currentDir, HitCount: 1, LastAccess: now})
 
-	if _, err := file.WriteString(currentDir + "\n"); err != nil {
+	if err := writeEntries(configPath, entries); err != nil {
 		fmt.Fprintf(os.Stderr, "Error writing to config file: %v\n", err)
 		os.Exit(1)
 	}
@@ -105,76 +185,253 @@ func addFolder(configPath string) {
 	fmt.Printf("Added current folder to jump list: %s\n", currentDir)
 }
 
-// jumpToFolder prints the path to jump to for shell function to use
+// jumpToFolder prints the path to jump to for shell function to use. A
+// successful jump bumps the entry's hit count and last-access time so
+// future ranking reflects it.
 func jumpToFolder(configPath, arg string) {
-	folders, err := readFolderList(configPath)
+	entries, err := readEntries(configPath)
 	if err != nil {
-		os.Exit(1)  // Silent exit on error
+		os.Exit(1) // Silent exit on error
 	}
 
-	// Check if argument is a number
-	if num, err := strconv.Atoi(arg); err == nil && num > 0 && num <= len(folders) {
-		fmt.Print(folders[num-1])
+	ranked := rankedByFrecency(entries)
+
+	// Check if argument is a number (position in the frecency-ranked list,
+	// matching what `jumper list` displays).
+	if num, err := strconv.Atoi(arg); err == nil && num > 0 && num <= len(ranked) {
+		touchAndPrint(configPath, entries, ranked[num-1])
 		return
 	}
 
-	// Check if argument matches a folder path
-	for _, folder := range folders {
-		if filepath.Base(folder) == arg || folder == arg {
-			fmt.Print(folder)
-			return
+	// Exact basename/path match: prefer the highest-frecency match.
+	if match := bestMatch(entries, func(e *entry) bool {
+		return filepath.Base(e.Path) == arg || e.Path == arg
+	}); match != nil {
+		touchAndPrint(configPath, entries, match)
+		return
+	}
+
+	// Fuzzy match: case-insensitive substring across all paths.
+	needle := strings.ToLower(arg)
+	if match := bestMatch(entries, func(e *entry) bool {
+		return strings.Contains(strings.ToLower(e.Path), needle)
+	}); match != nil {
+		touchAndPrint(configPath, entries, match)
+		return
+	}
+
+	os.Exit(1) // Silent exit when folder not found
+}
+
+// bestMatch returns the entry satisfying pred with the highest frecency
+// score, breaking ties on the most recent access.
+func bestMatch(entries []*entry, pred func(*entry) bool) *entry {
+	now := time.Now().Unix()
+
+	var best *entry
+	var bestScore float64
+	for _, e := range entries {
+		if !pred(e) {
+			continue
 		}
+		score := frecencyScore(e, now)
+		if best == nil || score > bestScore || (score == bestScore && e.LastAccess > best.LastAccess) {
+			best = e
+			bestScore = score
+		}
+	}
+	return best
+}
+
+// touchAndPrint records a hit against e, persists it, and prints the path
+// for the calling shell function to cd into.
+func touchAndPrint(configPath string, entries []*entry, e *entry) {
+	e.HitCount++
+	e.LastAccess = time.Now().Unix()
+
+	if err := writeEntries(configPath, entries); err != nil {
+		// Still complete the jump even if we fail to persist the hit.
+		fmt.Fprintf(os.Stderr, "Warning: failed to update folder list: %v\n", err)
 	}
 
-	os.Exit(1)  // Silent exit when folder not found
+	fmt.Print(e.Path)
 }
 
-// listFolders displays all folders in the jump list
-func listFolders(configPath string) {
-	folders, err := readFolderList(configPath)
+// frecencyScore combines hit count and recency the way z/autojump do:
+// score = hitCount * timeWeight, where timeWeight decays the longer it's
+// been since the entry was last accessed.
+func frecencyScore(e *entry, now int64) float64 {
+	age := now - e.LastAccess
+
+	var timeWeight float64
+	switch {
+	case age < 3600: // within the last hour
+		timeWeight = 4.0
+	case age < 86400: // within the last day
+		timeWeight = 2.0
+	case age < 604800: // within the last week
+		timeWeight = 0.5
+	default:
+		timeWeight = 0.25
+	}
+
+	return float64(e.HitCount) * timeWeight
+}
+
+// rankedByFrecency returns entries sorted by frecency score descending,
+// breaking ties on most-recent access.
+func rankedByFrecency(entries []*entry) []*entry {
+	now := time.Now().Unix()
+
+	ranked := make([]*entry, len(entries))
+	copy(ranked, entries)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		si, sj := frecencyScore(ranked[i], now), frecencyScore(ranked[j], now)
+		if si != sj {
+			return si > sj
+		}
+		return ranked[i].LastAccess > ranked[j].LastAccess
+	})
+
+	return ranked
+}
+
+// listFolders displays all folders in the jump list, ranked by frecency.
+// With format == "paths" it prints one path per line with no header or
+// score column, so shell completion scripts can consume it directly
+// instead of scraping the human-formatted output.
+func listFolders(configPath, format string) {
+	entries, err := readEntries(configPath)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error reading folder list: %v\n", err)
 		os.Exit(1)
 	}
 
-	if len(folders) == 0 {
+	ranked := rankedByFrecency(entries)
+
+	if format == "paths" {
+		for _, e := range ranked {
+			fmt.Println(e.Path)
+		}
+		return
+	}
+
+	if len(entries) == 0 {
 		fmt.Println("No folders in jump list. Use 'jumper add' to add the current folder.")
 		return
 	}
 
-	fmt.Println("Available folders:")
-	for i, folder := range folders {
-		fmt.Printf("%d. %s\n", i+1, folder)
+	now := time.Now().Unix()
+
+	fmt.Println("Available folders (ranked by frecency):")
+	for i, e := range ranked {
+		fmt.Printf("%d. %s\t(score: %.2f)\n", i+1, e.Path, frecencyScore(e, now))
 	}
 }
 
-// readFolderList reads and returns the list of folders from the config file
-func readFolderList(configPath string) ([]string, error) {
+// readEntries reads the jump list from configPath. It transparently
+// understands the legacy one-path-per-line format and migrates the file
+// to the tab-separated path/hitCount/lastAccess format in place the
+// first time it's read.
+func readEntries(configPath string) ([]*entry, error) {
 	file, err := os.Open(configPath)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
 
-	var folders []string
+	var entries []*entry
+	legacy := false
+
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
 		line := strings.TrimSpace(scanner.Text())
-		if line != "" {
-			folders = append(folders, line)
+		if line == "" {
+			continue
 		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) == 3 {
+			hitCount, errHit := strconv.Atoi(fields[1])
+			lastAccess, errAccess := strconv.ParseInt(fields[2], 10, 64)
+			if errHit == nil && errAccess == nil {
+				entries = append(entries, &entry{Path: fields[0], HitCount: hitCount, LastAccess: lastAccess})
+				continue
+			}
+		}
+
+		// Legacy plain-text line: just the path.
+		legacy = true
+		entries = append(entries, &entry{Path: line, HitCount: 1, LastAccess: time.Now().Unix()})
 	}
 
-	if err := scanner.Err(); err != nil {
+	err = scanner.Err()
+	file.Close()
+	if err != nil {
 		return nil, err
 	}
 
-	return folders, nil
+	if legacy {
+		if err := writeEntries(configPath, entries); err != nil {
+			return nil, err
+		}
+		fmt.Fprintln(os.Stderr, "Migrated folder list to the frecency-ranked format.")
+	}
+
+	return entries, nil
+}
+
+// writeEntries writes the jump list back to configPath in the tab-separated
+// path/hitCount/lastAccess format.
+func writeEntries(configPath string, entries []*entry) error {
+	file, err := os.Create(configPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return writeExportTSV(file, entries)
 }
 
-// setupJumper creates the jumper.sh file and adds it to the shell configuration
-func setupJumper(configDir, homeDir string) {
-	jumperScript := `#!/bin/bash
+// flagValue looks for "--name=value" among args and returns value, or ""
+// if the flag isn't present.
+func flagValue(args []string, name string) string {
+	prefix := "--" + name + "="
+	for _, a := range args {
+		if strings.HasPrefix(a, prefix) {
+			return strings.TrimPrefix(a, prefix)
+		}
+	}
+	return ""
+}
+
+// hasFlag reports whether "--name" is present among args.
+func hasFlag(args []string, name string) bool {
+	flag := "--" + name
+	for _, a := range args {
+		if a == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// detectShell resolves which shell to generate setup for: an explicit
+// --shell flag wins, otherwise it's inferred from $SHELL, defaulting to
+// bash if that isn't set.
+func detectShell(shellFlag string) string {
+	if shellFlag != "" {
+		return strings.ToLower(shellFlag)
+	}
+	if shellEnv := os.Getenv("SHELL"); shellEnv != "" {
+		return filepath.Base(shellEnv)
+	}
+	return "bash"
+}
+
+// jumperShScript is sourced by bash and zsh alike; it feature-detects
+// which shell it's running under to install the right completion hooks.
+const jumperShScript = `#!/bin/bash
 
 # Function to jump to a folder
 jp() {
@@ -182,48 +439,90 @@ jp() {
         jumper list
         return
     fi
-    
+
     local target=$(jumper "$1")
     if [ $? -eq 0 ]; then
         cd "$target"
     fi
 }
 
-# Bash completion for jp
-_jp_complete() {
-    local cur prev
-    COMPREPLY=()
-    cur="${COMP_WORDS[COMP_CWORD]}"
-    prev="${COMP_WORDS[COMP_CWORD-1]}"
-    
-    if [ "$prev" = "jumper" ]; then
-        COMPREPLY=( $(compgen -W "add list remove setup" -- "$cur") )
-    elif [ "$prev" = "remove" ]; then
-        # Get folder names for remove command
-        local folders=$(jumper list | grep -v "Available folders:" | sed 's/^[0-9]*\. \(.*\)$/\1/' | xargs -n1 basename)
-        COMPREPLY=( $(compgen -W "$folders" -- "$cur") )
-    elif [ "$prev" = "jp" ]; then
-        # Get folder names for jp command
-        local folders=$(jumper list | grep -v "Available folders:" | sed 's/^[0-9]*\. \(.*\)$/\1/' | xargs -n1 basename)
-        COMPREPLY=( $(compgen -W "$folders" -- "$cur") )
-    fi
-    
-    return 0
-}
+if [ -n "$ZSH_VERSION" ]; then
+    # zsh completion
+    _jp() {
+        local -a folders
+        folders=("${(@f)$(jumper list --format=paths)}")
+        _describe 'folder' folders
+    }
+    compdef _jp jp
+    compdef _jp jumper
+elif [ -n "$BASH_VERSION" ]; then
+    # bash completion
+    _jp_complete() {
+        local cur prev
+        COMPREPLY=()
+        cur="${COMP_WORDS[COMP_CWORD]}"
+        prev="${COMP_WORDS[COMP_CWORD-1]}"
+
+        if [ "$prev" = "jumper" ]; then
+            COMPREPLY=( $(compgen -W "add list remove clean export import setup" -- "$cur") )
+        elif [ "$prev" = "remove" ] || [ "$prev" = "jp" ]; then
+            local folders=$(jumper list --format=paths | xargs -n1 basename)
+            COMPREPLY=( $(compgen -W "$folders" -- "$cur") )
+        fi
+
+        return 0
+    }
+
+    complete -F _jp_complete jp
+    complete -F _jp_complete jumper
+fi`
+
+// jumperFishScript is dropped into ~/.config/fish/conf.d so fish picks it
+// up automatically; fish doesn't need an rc-file source line.
+const jumperFishScript = `function jp
+    if test -z "$argv[1]"
+        jumper list
+        return
+    end
+
+    set -l target (jumper $argv[1])
+    if test $status -eq 0
+        cd $target
+    end
+end
+
+complete -c jp -f -a '(jumper list --format=paths)'
+complete -c jumper -f -a '(jumper list --format=paths)'
+`
+
+// setupJumper writes the shell integration script for the detected (or
+// explicitly requested) shell and wires it up to get sourced automatically.
+func setupJumper(homeDir, shellFlag string) {
+	dataDir := resolveDataDir(homeDir)
+	if err := os.MkdirAll(dataDir, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", dataDir, err)
+		os.Exit(1)
+	}
 
-complete -F _jp_complete jp
-complete -F _jp_complete jumper`
+	switch detectShell(shellFlag) {
+	case "fish":
+		setupFish(homeDir)
+	case "zsh":
+		setupPosixShell(dataDir, homeDir, []string{".zshrc"})
+	default:
+		setupPosixShell(dataDir, homeDir, []string{".bashrc", ".bash_aliases"})
+	}
+}
 
-	// Write jumper.sh file (overwriting if it exists)
-	scriptPath := filepath.Join(configDir, "jumper.sh")
-	err := os.WriteFile(scriptPath, []byte(jumperScript), 0644)
-	if err != nil {
+// setupPosixShell writes jumper.sh and sources it from the first rc file
+// in rcFiles that exists, used for both bash and zsh.
+func setupPosixShell(dataDir, homeDir string, rcFiles []string) {
+	scriptPath := filepath.Join(dataDir, "jumper.sh")
+	if err := os.WriteFile(scriptPath, []byte(jumperShScript), 0644); err != nil {
 		fmt.Fprintf(os.Stderr, "Error creating jumper.sh: %v\n", err)
 		os.Exit(1)
 	}
 
-	// Check for .bashrc and .bash_aliases
-	rcFiles := []string{".bashrc", ".bash_aliases"}
 	sourceCmd := fmt.Sprintf("\n# Jumper configuration\nsource %s\n", scriptPath)
 
 	for _, rcFile := range rcFiles {
@@ -248,16 +547,16 @@ complete -F _jp_complete jumper`
 				fmt.Fprintf(os.Stderr, "Error opening %s: %v\n", rcFile, err)
 				continue
 			}
-			
+
 			if _, err := f.WriteString(sourceCmd); err != nil {
 				fmt.Fprintf(os.Stderr, "Error writing to %s: %v\n", rcFile, err)
 				f.Close()
 				continue
 			}
 			f.Close()
-			
+
 			fmt.Printf("Added jumper configuration to %s\n", rcFile)
-			break  // Successfully added to one file, no need to continue
+			break // Successfully added to one file, no need to continue
 		}
 	}
 
@@ -265,28 +564,47 @@ complete -F _jp_complete jumper`
 	fmt.Printf("source %s\n", scriptPath)
 }
 
+// setupFish drops jumper.fish into ~/.config/fish/conf.d, which fish
+// auto-sources on startup, so no rc file needs editing.
+func setupFish(homeDir string) {
+	fishConfDir := filepath.Join(homeDir, ".config", "fish", "conf.d")
+	if err := os.MkdirAll(fishConfDir, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", fishConfDir, err)
+		os.Exit(1)
+	}
+
+	scriptPath := filepath.Join(fishConfDir, "jumper.fish")
+	if err := os.WriteFile(scriptPath, []byte(jumperFishScript), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating jumper.fish: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Setup complete! Restart your shell or run:")
+	fmt.Printf("source %s\n", scriptPath)
+}
+
 // removeFolder removes a folder from the jump list
 func removeFolder(configPath, arg string) {
-	folders, err := readFolderList(configPath)
+	entries, err := readEntries(configPath)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error reading folder list: %v\n", err)
 		os.Exit(1)
 	}
 
-	if len(folders) == 0 {
+	if len(entries) == 0 {
 		fmt.Println("No folders in jump list.")
 		return
 	}
 
-	var indexToRemove int = -1
+	indexToRemove := -1
 
 	// Check if argument is a number
-	if num, err := strconv.Atoi(arg); err == nil && num > 0 && num <= len(folders) {
+	if num, err := strconv.Atoi(arg); err == nil && num > 0 && num <= len(entries) {
 		indexToRemove = num - 1
 	} else {
 		// Check if argument matches a folder name
-		for i, folder := range folders {
-			if filepath.Base(folder) == arg || folder == arg {
+		for i, e := range entries {
+			if filepath.Base(e.Path) == arg || e.Path == arg {
 				indexToRemove = i
 				break
 			}
@@ -299,23 +617,355 @@ func removeFolder(configPath, arg string) {
 	}
 
 	// Remove the folder and write back to file
-	removedFolder := folders[indexToRemove]
-	folders = append(folders[:indexToRemove], folders[indexToRemove+1:]...)
+	removedFolder := entries[indexToRemove].Path
+	entries = append(entries[:indexToRemove], entries[indexToRemove+1:]...)
 
-	// Write the updated list back to the file
-	file, err := os.Create(configPath)
+	if err := writeEntries(configPath, entries); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing to config file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Removed folder: %s\n", removedFolder)
+}
+
+// cleanFolders garbage-collects stale entries from the jump list: paths
+// that no longer exist or are no longer directories, and optionally
+// entries that haven't been accessed in a while. The list is backed up
+// to a .bak file before being rewritten so a mistaken clean can be
+// undone with `jumper clean --undo`.
+func cleanFolders(configPath string, args []string) {
+	if hasFlag(args, "undo") {
+		undoClean(configPath)
+		return
+	}
+
+	dryRun := hasFlag(args, "dry-run")
+	brokenOnly := hasFlag(args, "broken")
+
+	var olderThan time.Duration
+	if s := flagValue(args, "older-than"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Invalid --older-than duration %q: %v\n", s, err)
+			os.Exit(1)
+		}
+		olderThan = d
+	}
+
+	entries, err := readEntries(configPath)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error opening config file: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error reading folder list: %v\n", err)
 		os.Exit(1)
 	}
-	defer file.Close()
 
-	for _, folder := range folders {
-		if _, err := file.WriteString(folder + "\n"); err != nil {
+	now := time.Now()
+	var kept, removed []*entry
+
+	for _, e := range entries {
+		var stale bool
+		if brokenOnly {
+			stale = pathMissing(e.Path)
+		} else {
+			stale = pathMissing(e.Path) || pathNotDir(e.Path)
+			if !stale && olderThan > 0 {
+				stale = now.Sub(time.Unix(e.LastAccess, 0)) > olderThan
+			}
+		}
+
+		if stale {
+			removed = append(removed, e)
+		} else {
+			kept = append(kept, e)
+		}
+	}
+
+	for _, e := range removed {
+		if dryRun {
+			fmt.Printf("Would remove: %s\n", e.Path)
+		} else {
+			fmt.Printf("Removed: %s\n", e.Path)
+		}
+	}
+
+	if dryRun {
+		fmt.Printf("Summary: %d kept, %d would be removed\n", len(kept), len(removed))
+		return
+	}
+
+	if len(removed) > 0 {
+		if err := backupConfig(configPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error backing up config file: %v\n", err)
+			os.Exit(1)
+		}
+		if err := writeEntries(configPath, kept); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing to config file: %v\n", err)
 			os.Exit(1)
 		}
 	}
 
-	fmt.Printf("Removed folder: %s\n", removedFolder)
-}
\ No newline at end of file
+	fmt.Printf("Summary: %d kept, %d removed\n", len(kept), len(removed))
+}
+
+// pathMissing reports whether path no longer exists.
+func pathMissing(path string) bool {
+	_, err := os.Stat(path)
+	return os.IsNotExist(err)
+}
+
+// pathNotDir reports whether path exists but is no longer a directory.
+func pathNotDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// backupConfig copies configPath to configPath+".bak", overwriting any
+// previous backup, so a clean can be undone.
+func backupConfig(configPath string) error {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(configPath+".bak", data, 0644)
+}
+
+// undoClean restores the folder list from the .bak file written by the
+// most recent `jumper clean`.
+func undoClean(configPath string) {
+	data, err := os.ReadFile(configPath + ".bak")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "No backup to restore: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(configPath, data, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error restoring config file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Restored folder list from backup.")
+}
+
+// exportRecord is the on-the-wire shape used by `jumper export`/`jumper
+// import` for both the JSON and TSV formats.
+type exportRecord struct {
+	Path       string `json:"path"`
+	HitCount   int    `json:"hitCount"`
+	LastAccess int64  `json:"lastAccess"`
+}
+
+// exportFolders writes the jump list to stdout (or --out=<path>) so it
+// can be carried to another machine.
+func exportFolders(configPath string, args []string) {
+	format := flagValue(args, "format")
+	if format == "" {
+		format = "tsv"
+	}
+	if format != "json" && format != "tsv" {
+		fmt.Fprintf(os.Stderr, "Unknown export format %q (want json or tsv)\n", format)
+		os.Exit(1)
+	}
+
+	entries, err := readEntries(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading folder list: %v\n", err)
+		os.Exit(1)
+	}
+
+	w := io.Writer(os.Stdout)
+	if outPath := flagValue(args, "out"); outPath != "" {
+		file, err := os.Create(outPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", outPath, err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if format == "json" {
+		err = writeExportJSON(w, entries)
+	} else {
+		err = writeExportTSV(w, entries)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing export: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func writeExportJSON(w io.Writer, entries []*entry) error {
+	records := make([]exportRecord, len(entries))
+	for i, e := range entries {
+		records[i] = exportRecord{Path: e.Path, HitCount: e.HitCount, LastAccess: e.LastAccess}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
+
+func writeExportTSV(w io.Writer, entries []*entry) error {
+	for _, e := range entries {
+		if _, err := fmt.Fprintf(w, "%s\t%d\t%d\n", e.Path, e.HitCount, e.LastAccess); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchesPathPrefix reports whether path is prefix or a descendant of it,
+// requiring a path-segment boundary so "/home/alice" doesn't also match
+// "/home/alice2/other".
+func matchesPathPrefix(path, prefix string) bool {
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// importFolders reads an exported jump list back in, optionally rebasing
+// path prefixes so a dotfiles-synced list works across users. By default
+// incoming entries are merged with the existing list (hit counts summed,
+// last-access taking the max); --replace discards the existing list
+// instead. Entries whose path isn't absolute (even after rebasing) are
+// skipped with a warning, or fail the import entirely under --strict.
+// The existing list is backed up to a .bak file first, the same as
+// `jumper clean`, so a bad import can be undone with `jumper clean --undo`.
+func importFolders(configPath, filePath string, args []string) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", filePath, err)
+		os.Exit(1)
+	}
+
+	imported, err := parseImportData(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing %s: %v\n", filePath, err)
+		os.Exit(1)
+	}
+
+	rebaseOld, rebaseNew := "", ""
+	if r := flagValue(args, "rebase"); r != "" {
+		parts := strings.SplitN(r, "=", 2)
+		if len(parts) != 2 {
+			fmt.Fprintf(os.Stderr, "Invalid --rebase=%q, want oldPrefix=newPrefix\n", r)
+			os.Exit(1)
+		}
+		rebaseOld, rebaseNew = parts[0], parts[1]
+	}
+
+	strict := hasFlag(args, "strict")
+
+	var valid []*entry
+	skipped := 0
+	for _, e := range imported {
+		if rebaseOld != "" && matchesPathPrefix(e.Path, rebaseOld) {
+			e.Path = rebaseNew + strings.TrimPrefix(e.Path, rebaseOld)
+		}
+		if !filepath.IsAbs(e.Path) {
+			fmt.Fprintf(os.Stderr, "Warning: skipping non-absolute path %q\n", e.Path)
+			skipped++
+			continue
+		}
+		valid = append(valid, e)
+	}
+
+	if strict && skipped > 0 {
+		os.Exit(1)
+	}
+
+	final := valid
+	if !hasFlag(args, "replace") {
+		existing, err := readEntries(configPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading folder list: %v\n", err)
+			os.Exit(1)
+		}
+		final = mergeEntries(existing, valid)
+	}
+
+	if err := backupConfig(configPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error backing up config file: %v\n", err)
+		os.Exit(1)
+	}
+	if err := writeEntries(configPath, final); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing to config file: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Imported %d folders (%d skipped)\n", len(valid), skipped)
+}
+
+// parseImportData accepts either the JSON or TSV shape written by
+// `jumper export`.
+func parseImportData(data []byte) ([]*entry, error) {
+	trimmed := strings.TrimSpace(string(data))
+
+	if strings.HasPrefix(trimmed, "[") {
+		var records []exportRecord
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, err
+		}
+		entries := make([]*entry, len(records))
+		for i, r := range records {
+			entries[i] = &entry{Path: r.Path, HitCount: r.HitCount, LastAccess: r.LastAccess}
+		}
+		return entries, nil
+	}
+
+	var entries []*entry
+	scanner := bufio.NewScanner(strings.NewReader(trimmed))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("malformed line: %q", line)
+		}
+		hitCount, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		lastAccess, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry{Path: fields[0], HitCount: hitCount, LastAccess: lastAccess})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// mergeEntries unions incoming into existing by path, summing hit counts
+// and keeping the most recent last-access time, preserving existing's
+// order with new paths appended in the order they're first seen.
+func mergeEntries(existing, incoming []*entry) []*entry {
+	byPath := make(map[string]*entry, len(existing)+len(incoming))
+	var order []string
+
+	for _, e := range existing {
+		byPath[e.Path] = &entry{Path: e.Path, HitCount: e.HitCount, LastAccess: e.LastAccess}
+		order = append(order, e.Path)
+	}
+
+	for _, e := range incoming {
+		if cur, ok := byPath[e.Path]; ok {
+			cur.HitCount += e.HitCount
+			if e.LastAccess > cur.LastAccess {
+				cur.LastAccess = e.LastAccess
+			}
+			continue
+		}
+		byPath[e.Path] = &entry{Path: e.Path, HitCount: e.HitCount, LastAccess: e.LastAccess}
+		order = append(order, e.Path)
+	}
+
+	merged := make([]*entry, len(order))
+	for i, p := range order {
+		merged[i] = byPath[p]
+	}
+	return merged
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFrecencyScore(t *testing.T) {
+	now := int64(1_700_000_000)
+
+	tests := []struct {
+		name string
+		e    *entry
+		want float64
+	}{
+		{"within the hour", &entry{HitCount: 3, LastAccess: now - 60}, 12.0},
+		{"within the day", &entry{HitCount: 3, LastAccess: now - 3*3600}, 6.0},
+		{"within the week", &entry{HitCount: 3, LastAccess: now - 2*86400}, 1.5},
+		{"older than a week", &entry{HitCount: 3, LastAccess: now - 30*86400}, 0.75},
+		{"exactly one hour old counts as the next bucket", &entry{HitCount: 1, LastAccess: now - 3600}, 2.0},
+		{"never accessed", &entry{HitCount: 0, LastAccess: now}, 0.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := frecencyScore(tt.e, now); got != tt.want {
+				t.Errorf("frecencyScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankedByFrecency(t *testing.T) {
+	now := time.Now().Unix()
+
+	a := &entry{Path: "/a", HitCount: 1, LastAccess: now - 30*86400} // low score, old
+	b := &entry{Path: "/b", HitCount: 10, LastAccess: now - 60}      // high score, recent
+	c := &entry{Path: "/c", HitCount: 10, LastAccess: now - 120}     // same score as b, but older
+
+	ranked := rankedByFrecency([]*entry{a, b, c})
+
+	want := []string{"/b", "/c", "/a"}
+	for i, w := range want {
+		if ranked[i].Path != w {
+			t.Errorf("ranked[%d] = %s, want %s", i, ranked[i].Path, w)
+		}
+	}
+
+	// rankedByFrecency must not mutate or reorder the caller's slice.
+	if a.Path != "/a" {
+		t.Errorf("input slice element mutated unexpectedly")
+	}
+}
+
+func TestMatchesPathPrefix(t *testing.T) {
+	tests := []struct {
+		path, prefix string
+		want         bool
+	}{
+		{"/home/alice", "/home/alice", true},
+		{"/home/alice/proj", "/home/alice", true},
+		{"/home/alice2/other", "/home/alice", false},
+		{"/home/alicia", "/home/alice", false},
+		{"/home/al", "/home/alice", false},
+	}
+
+	for _, tt := range tests {
+		if got := matchesPathPrefix(tt.path, tt.prefix); got != tt.want {
+			t.Errorf("matchesPathPrefix(%q, %q) = %v, want %v", tt.path, tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestMergeEntries(t *testing.T) {
+	existing := []*entry{
+		{Path: "/a", HitCount: 2, LastAccess: 100},
+		{Path: "/b", HitCount: 1, LastAccess: 200},
+	}
+	incoming := []*entry{
+		{Path: "/a", HitCount: 3, LastAccess: 50},  // older access, hit count sums
+		{Path: "/b", HitCount: 5, LastAccess: 500}, // newer access wins
+		{Path: "/c", HitCount: 1, LastAccess: 10},  // new path appended
+	}
+
+	merged := mergeEntries(existing, incoming)
+
+	if len(merged) != 3 {
+		t.Fatalf("len(merged) = %d, want 3", len(merged))
+	}
+
+	byPath := make(map[string]*entry, len(merged))
+	for _, e := range merged {
+		byPath[e.Path] = e
+	}
+
+	if got := byPath["/a"]; got.HitCount != 5 || got.LastAccess != 100 {
+		t.Errorf("/a merged = %+v, want HitCount=5 LastAccess=100", got)
+	}
+	if got := byPath["/b"]; got.HitCount != 6 || got.LastAccess != 500 {
+		t.Errorf("/b merged = %+v, want HitCount=6 LastAccess=500", got)
+	}
+	if got := byPath["/c"]; got.HitCount != 1 || got.LastAccess != 10 {
+		t.Errorf("/c merged = %+v, want HitCount=1 LastAccess=10", got)
+	}
+
+	// existing's entries must not be mutated in place.
+	if existing[0].HitCount != 2 {
+		t.Errorf("existing[0].HitCount mutated to %d, want unchanged 2", existing[0].HitCount)
+	}
+}
+
+func TestPathMissingAndNotDir(t *testing.T) {
+	dir := t.TempDir()
+
+	realDir := filepath.Join(dir, "subdir")
+	if err := os.Mkdir(realDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	realFile := filepath.Join(dir, "plainfile")
+	if err := os.WriteFile(realFile, []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	missingPath := filepath.Join(dir, "does-not-exist")
+
+	if pathMissing(realDir) {
+		t.Errorf("pathMissing(%q) = true, want false", realDir)
+	}
+	if pathNotDir(realDir) {
+		t.Errorf("pathNotDir(%q) = true, want false", realDir)
+	}
+
+	if pathMissing(realFile) {
+		t.Errorf("pathMissing(%q) = true, want false", realFile)
+	}
+	if !pathNotDir(realFile) {
+		t.Errorf("pathNotDir(%q) = false, want true", realFile)
+	}
+
+	if !pathMissing(missingPath) {
+		t.Errorf("pathMissing(%q) = false, want true", missingPath)
+	}
+	if pathNotDir(missingPath) {
+		t.Errorf("pathNotDir(%q) = true, want false for a missing path", missingPath)
+	}
+}
+
+// TestCleanFolders exercises the three stale-detection paths cleanFolders
+// combines: the default (missing-or-not-a-directory) test, --broken
+// (missing only), and --older-than (by last-access age).
+func TestCleanFolders(t *testing.T) {
+	newConfig := func(t *testing.T, dir string, entries []*entry) string {
+		t.Helper()
+		configPath := filepath.Join(dir, "folders")
+		if err := writeEntries(configPath, entries); err != nil {
+			t.Fatal(err)
+		}
+		return configPath
+	}
+
+	readBack := func(t *testing.T, configPath string) []*entry {
+		t.Helper()
+		entries, err := readEntries(configPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return entries
+	}
+
+	// Silence cleanFolders' progress output for the duration of each subtest.
+	withQuietStdout := func(t *testing.T, fn func()) {
+		t.Helper()
+		old := os.Stdout
+		devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		os.Stdout = devNull
+		defer func() {
+			os.Stdout = old
+			devNull.Close()
+		}()
+		fn()
+	}
+
+	t.Run("default removes missing and non-directory entries", func(t *testing.T) {
+		dir := t.TempDir()
+		realDir := filepath.Join(dir, "real")
+		if err := os.Mkdir(realDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		plainFile := filepath.Join(dir, "plainfile")
+		if err := os.WriteFile(plainFile, []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		missing := filepath.Join(dir, "missing")
+
+		now := time.Now().Unix()
+		configPath := newConfig(t, dir, []*entry{
+			{Path: realDir, HitCount: 1, LastAccess: now},
+			{Path: plainFile, HitCount: 1, LastAccess: now},
+			{Path: missing, HitCount: 1, LastAccess: now},
+		})
+
+		withQuietStdout(t, func() {
+			cleanFolders(configPath, nil)
+		})
+
+		kept := readBack(t, configPath)
+		if len(kept) != 1 || kept[0].Path != realDir {
+			t.Errorf("kept = %+v, want only %s", kept, realDir)
+		}
+	})
+
+	t.Run("--broken only removes non-existent paths", func(t *testing.T) {
+		dir := t.TempDir()
+		plainFile := filepath.Join(dir, "plainfile")
+		if err := os.WriteFile(plainFile, []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		missing := filepath.Join(dir, "missing")
+
+		now := time.Now().Unix()
+		configPath := newConfig(t, dir, []*entry{
+			{Path: plainFile, HitCount: 1, LastAccess: now},
+			{Path: missing, HitCount: 1, LastAccess: now},
+		})
+
+		withQuietStdout(t, func() {
+			cleanFolders(configPath, []string{"--broken"})
+		})
+
+		kept := readBack(t, configPath)
+		if len(kept) != 1 || kept[0].Path != plainFile {
+			t.Errorf("kept = %+v, want only %s (a stale-but-existing file should survive --broken)", kept, plainFile)
+		}
+	})
+
+	t.Run("--older-than removes entries stale by access time", func(t *testing.T) {
+		dir := t.TempDir()
+		fresh := filepath.Join(dir, "fresh")
+		stale := filepath.Join(dir, "stale")
+		for _, p := range []string{fresh, stale} {
+			if err := os.Mkdir(p, 0755); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		now := time.Now()
+		configPath := newConfig(t, dir, []*entry{
+			{Path: fresh, HitCount: 1, LastAccess: now.Unix()},
+			{Path: stale, HitCount: 1, LastAccess: now.Add(-48 * time.Hour).Unix()},
+		})
+
+		withQuietStdout(t, func() {
+			cleanFolders(configPath, []string{"--older-than=24h"})
+		})
+
+		kept := readBack(t, configPath)
+		if len(kept) != 1 || kept[0].Path != fresh {
+			t.Errorf("kept = %+v, want only %s", kept, fresh)
+		}
+	})
+
+	t.Run("--dry-run leaves the file untouched", func(t *testing.T) {
+		dir := t.TempDir()
+		missing := filepath.Join(dir, "missing")
+
+		now := time.Now().Unix()
+		configPath := newConfig(t, dir, []*entry{
+			{Path: missing, HitCount: 1, LastAccess: now},
+		})
+
+		withQuietStdout(t, func() {
+			cleanFolders(configPath, []string{"--dry-run"})
+		})
+
+		kept := readBack(t, configPath)
+		if len(kept) != 1 {
+			t.Errorf("--dry-run modified the file: kept = %+v, want the original untouched entry", kept)
+		}
+	})
+}